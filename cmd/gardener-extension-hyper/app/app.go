@@ -0,0 +1,38 @@
+// Copyright (c) 2018 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package app bundles every gardener-extension-provider-gcp binary into a single "hyper" command, in which each
+// binary is a subcommand named after it. Each bundled binary registers its root command here the same way it
+// registers in its own cmd/<binary>/main.go.
+package app
+
+import (
+	"github.com/spf13/cobra"
+
+	validatorapp "github.com/gardener/gardener-extension-provider-gcp/cmd/gardener-extension-validator-gcp/app"
+)
+
+// NewHyperCommand creates a new hyper command that bundles all gardener-extension-provider-gcp binaries.
+func NewHyperCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "gardener-extension-hyper",
+		Short: "Bundles all gardener-extension-provider-gcp commands into a single binary",
+	}
+
+	cmd.AddCommand(
+		validatorapp.NewValidatorCommand(),
+	)
+
+	return cmd
+}
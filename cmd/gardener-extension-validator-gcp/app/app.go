@@ -0,0 +1,78 @@
+// Copyright (c) 2018 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package app
+
+import (
+	"fmt"
+
+	"github.com/gardener/gardener-extension-provider-gcp/pkg/validator"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	"github.com/spf13/cobra"
+	"k8s.io/client-go/kubernetes/scheme"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+	"sigs.k8s.io/controller-runtime/pkg/webhook"
+)
+
+// Name is the name of the validator binary.
+const Name = "gardener-extension-validator-gcp"
+
+// Options carries the command line options for the validator.
+type Options struct {
+	webhookServerPort int
+	webhookCertDir    string
+}
+
+// NewValidatorCommand creates the root command for the GCP core resource validator webhook.
+func NewValidatorCommand() *cobra.Command {
+	opts := &Options{}
+
+	cmd := &cobra.Command{
+		Use:   Name,
+		Short: "Validates GCP-specific Shoot, SecretBinding and CloudProfile resources before they are persisted",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return run(opts)
+		},
+	}
+
+	cmd.Flags().IntVar(&opts.webhookServerPort, "webhook-config-server-port", 9443, "port of the webhook server")
+	cmd.Flags().StringVar(&opts.webhookCertDir, "webhook-config-cert-dir", "/tmp/gardener-extension-validator-gcp-certs", "directory holding the webhook server TLS certificate")
+
+	return cmd
+}
+
+func run(opts *Options) error {
+	if err := gardencorev1beta1.AddToScheme(scheme.Scheme); err != nil {
+		return fmt.Errorf("could not add Gardener core types to scheme: %w", err)
+	}
+
+	mgr, err := ctrl.NewManager(ctrl.GetConfigOrDie(), manager.Options{
+		Scheme: scheme.Scheme,
+		WebhookServer: webhook.NewServer(webhook.Options{
+			Port:    opts.webhookServerPort,
+			CertDir: opts.webhookCertDir,
+		}),
+	})
+	if err != nil {
+		return fmt.Errorf("could not instantiate manager: %w", err)
+	}
+
+	if err := validator.AddToManager(mgr); err != nil {
+		return fmt.Errorf("could not add validator webhooks to manager: %w", err)
+	}
+
+	return mgr.Start(ctrl.SetupSignalHandler())
+}
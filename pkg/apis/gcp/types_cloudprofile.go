@@ -0,0 +1,46 @@
+// Copyright (c) 2018 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gcp
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// CloudProfileConfig contains provider-specific configuration that is embedded into Gardener's `CloudProfile`
+// resource.
+type CloudProfileConfig struct {
+	metav1.TypeMeta `json:",inline"`
+	// MachineImages is the list of machine images that are understood by the controller.
+	MachineImages []MachineImages `json:"machineImages"`
+}
+
+// MachineImages is a mapping from logical names and versions to provider-specific identifiers.
+type MachineImages struct {
+	// Name is the logical name of the machine image.
+	Name string `json:"name"`
+	// Versions contains versions, images, and, optionally, the
+	// architecture for an architecture-specific image.
+	Versions []MachineImageVersion `json:"versions"`
+}
+
+// MachineImageVersion contains a version and a provider-specific identifier.
+type MachineImageVersion struct {
+	// Version is the version of the image.
+	Version string `json:"version"`
+	// Image is the path to the image.
+	Image string `json:"image"`
+}
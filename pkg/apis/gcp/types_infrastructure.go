@@ -0,0 +1,144 @@
+// Copyright (c) 2018 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gcp
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// InfrastructureConfig infrastructure configuration resource
+type InfrastructureConfig struct {
+	metav1.TypeMeta `json:",inline"`
+	// Networks is the network configuration (VPC, subnets, etc.)
+	Networks NetworkConfig `json:"networks"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// InfrastructureStatus contains information about created infrastructure resources.
+type InfrastructureStatus struct {
+	metav1.TypeMeta
+	// Networks is the status of the networks of the infrastructure.
+	Networks NetworkStatus
+}
+
+// NetworkStatus is the current status of the infrastructure networks.
+type NetworkStatus struct {
+	// VPC states the name of the infrastructure VPC.
+	VPC VPCResource
+}
+
+// VPCResource contains information about the VPC and some related resources.
+type VPCResource struct {
+	// Name is the VPC name.
+	Name string
+}
+
+// NetworkConfig holds information about the Kubernetes and infrastructure networks.
+type NetworkConfig struct {
+	// VPC indicates whether to use an existing VPC or create a new one.
+	// +optional
+	VPC *VPC `json:"vpc,omitempty"`
+	// CloudNAT contains configuration about the Cloud NAT resource.
+	// +optional
+	CloudNAT *CloudNAT `json:"cloudNAT,omitempty"`
+	// Internal is a private subnet (used for internal load balancers).
+	// +optional
+	Internal *string `json:"internal,omitempty"`
+	// Workers is the worker subnet range to create (used for the VMs).
+	// This field is mutually exclusive with Zones.
+	// +optional
+	Workers string `json:"workers,omitempty"`
+	// Zones is a list of zone-specific worker subnets. This field is mutually exclusive with Workers and allows
+	// isolating worker nodes into a dedicated subnet per zone instead of sharing a single subnet across all zones.
+	// +optional
+	Zones []ZoneNetworkConfig `json:"zones,omitempty"`
+	// IPFamilies specifies the IP protocol versions to use for the network. Each of Internal, Workers, every zone's
+	// Workers/Internal in Zones, and the Shoot's nodes/pods/services CIDRs must then contain exactly one CIDR per
+	// declared family, either as a single value or as a comma-separated IPv4+IPv6 pair. Defaults to IPv4 only.
+	// +optional
+	IPFamilies []IPFamily `json:"ipFamilies,omitempty"`
+	// FlowLogs contains the flow log configuration for the subnet.
+	// +optional
+	FlowLogs *FlowLogs `json:"flowLogs,omitempty"`
+}
+
+// IPFamily represents the IP family of a network.
+type IPFamily string
+
+const (
+	// IPv4 is the IPv4 IP family.
+	IPv4 IPFamily = "IPv4"
+	// IPv6 is the IPv6 IP family.
+	IPv6 IPFamily = "IPv6"
+)
+
+// ZoneNetworkConfig holds the network configuration of a worker subnet dedicated to a single zone.
+type ZoneNetworkConfig struct {
+	// Name is the name of the zone this network configuration belongs to.
+	Name string `json:"name"`
+	// Workers is the worker subnet range of this zone (used for the VMs). May be a single CIDR or a comma-separated
+	// IPv4+IPv6 pair, per the families declared in NetworkConfig.IPFamilies.
+	Workers string `json:"workers"`
+	// Internal is a private subnet of this zone (used for internal load balancers). May be a single CIDR or a
+	// comma-separated IPv4+IPv6 pair, per the families declared in NetworkConfig.IPFamilies.
+	// +optional
+	Internal *string `json:"internal,omitempty"`
+}
+
+// CloudNAT contains configuration about the CloudNAT resource.
+type CloudNAT struct {
+	// MinPortsPerVM is the minimum number of ports allocated to a VM from this NAT config.
+	// +optional
+	MinPortsPerVM *int32 `json:"minPortsPerVM,omitempty"`
+}
+
+// VPC contains information about the VPC and some related resources.
+type VPC struct {
+	// Name is the VPC name.
+	Name string `json:"name"`
+	// CloudRouter indicates whether a cloud router should be created.
+	// +optional
+	CloudRouter *CloudRouter `json:"cloudRouter,omitempty"`
+}
+
+// CloudRouter contains information about the cloud router configuration.
+type CloudRouter struct {
+	// Name is the cloud router name.
+	// +optional
+	Name string `json:"name,omitempty"`
+}
+
+// FlowLogs contains the configuration options for the VPC flow logs.
+type FlowLogs struct {
+	// AggregationInterval for collecting flow logs.
+	// +optional
+	AggregationInterval *string `json:"aggregationInterval,omitempty"`
+	// FlowSampling sets the sampling rate of VPC flow logs within the range [0, 1].
+	// +optional
+	FlowSampling *float32 `json:"flowSampling,omitempty"`
+	// Metadata configures whether metadata fields should be added to the reported VPC flow logs.
+	// +optional
+	Metadata *string `json:"metadata,omitempty"`
+	// MetadataFields lists the additional metadata fields to report. Only allowed, and required to be non-empty,
+	// when Metadata is set to "CUSTOM_METADATA".
+	// +optional
+	MetadataFields []string `json:"metadataFields,omitempty"`
+	// FilterExpr restricts which flow logs get reported to those matching the given filter expression.
+	// +optional
+	FilterExpr *string `json:"filterExpr,omitempty"`
+}
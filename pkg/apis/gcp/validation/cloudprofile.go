@@ -0,0 +1,55 @@
+// Copyright (c) 2018 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package validation
+
+import (
+	"fmt"
+
+	apisgcp "github.com/gardener/gardener-extension-provider-gcp/pkg/apis/gcp"
+
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+// ValidateCloudProfileConfig validates a CloudProfileConfig object.
+func ValidateCloudProfileConfig(cloudProfileConfig *apisgcp.CloudProfileConfig, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+	imagesPath := fldPath.Child("machineImages")
+
+	if len(cloudProfileConfig.MachineImages) == 0 {
+		allErrs = append(allErrs, field.Required(imagesPath, "must provide at least one machine image"))
+		return allErrs
+	}
+
+	for i, image := range cloudProfileConfig.MachineImages {
+		imagePath := imagesPath.Index(i)
+		if len(image.Name) == 0 {
+			allErrs = append(allErrs, field.Required(imagePath.Child("name"), "must provide a name"))
+		}
+		if len(image.Versions) == 0 {
+			allErrs = append(allErrs, field.Required(imagePath.Child("versions"), fmt.Sprintf("must provide at least one version for machine image %q", image.Name)))
+		}
+		for j, version := range image.Versions {
+			versionPath := imagePath.Child("versions").Index(j)
+			if len(version.Version) == 0 {
+				allErrs = append(allErrs, field.Required(versionPath.Child("version"), "must provide a version"))
+			}
+			if len(version.Image) == 0 {
+				allErrs = append(allErrs, field.Required(versionPath.Child("image"), "must provide an image"))
+			}
+		}
+	}
+
+	return allErrs
+}
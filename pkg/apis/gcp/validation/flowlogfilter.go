@@ -0,0 +1,227 @@
+// Copyright (c) 2018 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package validation
+
+import (
+	"fmt"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+// maxFlowLogFilterExprLength bounds the size of a filterExpr accepted for parsing. The webhook's default
+// failurePolicy is Fail, so an adversarial expression that is merely long must be rejected up front rather than
+// tokenized and parsed.
+const maxFlowLogFilterExprLength = 1024
+
+// maxFlowLogFilterExprDepth bounds how deeply parseExpr/parseUnary may recurse into nested parentheses or NOTs,
+// so a pathological expression (e.g. a long run of "NOT" or unmatched "(") can't drive unbounded recursion.
+const maxFlowLogFilterExprDepth = 32
+
+// validateFlowLogFilterExpr validates that expr is a syntactically valid VPC flow log filter expression built from
+// AND/OR/NOT, the comparison operators == and !=, and the in(...) membership operator, referencing only the field
+// names in availableFlowLogFields.
+func validateFlowLogFilterExpr(expr string, fldPath *field.Path) field.ErrorList {
+	if len(expr) > maxFlowLogFilterExprLength {
+		return field.ErrorList{field.Invalid(fldPath, expr, fmt.Sprintf("must not exceed %d characters", maxFlowLogFilterExprLength))}
+	}
+
+	tokens, err := tokenizeFlowLogFilterExpr(expr)
+	if err != nil {
+		return field.ErrorList{field.Invalid(fldPath, expr, err.Error())}
+	}
+
+	p := &flowLogFilterParser{tokens: tokens}
+	if err := p.parseExpr(); err != nil {
+		return field.ErrorList{field.Invalid(fldPath, expr, err.Error())}
+	}
+	if !p.atEnd() {
+		return field.ErrorList{field.Invalid(fldPath, expr, fmt.Sprintf("unexpected token %q", p.peek()))}
+	}
+
+	return nil
+}
+
+// flowLogFilterParser is a recursive-descent parser for the small boolean expression grammar accepted by
+// validateFlowLogFilterExpr:
+//
+//	expr       := and ( "OR" and )*
+//	and        := unary ( "AND" unary )*
+//	unary      := "NOT" unary | primary
+//	primary    := "(" expr ")" | comparison
+//	comparison := field ( "==" | "!=" ) literal | field "in" "(" literal ( "," literal )* ")"
+type flowLogFilterParser struct {
+	tokens []string
+	pos    int
+	depth  int
+}
+
+func (p *flowLogFilterParser) peek() string {
+	if p.pos >= len(p.tokens) {
+		return ""
+	}
+	return p.tokens[p.pos]
+}
+
+func (p *flowLogFilterParser) next() string {
+	tok := p.peek()
+	p.pos++
+	return tok
+}
+
+func (p *flowLogFilterParser) atEnd() bool {
+	return p.pos >= len(p.tokens)
+}
+
+func (p *flowLogFilterParser) parseExpr() error {
+	if err := p.parseAnd(); err != nil {
+		return err
+	}
+	for strings.EqualFold(p.peek(), "OR") {
+		p.next()
+		if err := p.parseAnd(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (p *flowLogFilterParser) parseAnd() error {
+	if err := p.parseUnary(); err != nil {
+		return err
+	}
+	for strings.EqualFold(p.peek(), "AND") {
+		p.next()
+		if err := p.parseUnary(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (p *flowLogFilterParser) parseUnary() error {
+	p.depth++
+	defer func() { p.depth-- }()
+	if p.depth > maxFlowLogFilterExprDepth {
+		return fmt.Errorf("expression nesting exceeds the maximum depth of %d", maxFlowLogFilterExprDepth)
+	}
+
+	if strings.EqualFold(p.peek(), "NOT") {
+		p.next()
+		return p.parseUnary()
+	}
+	return p.parsePrimary()
+}
+
+func (p *flowLogFilterParser) parsePrimary() error {
+	if p.peek() == "(" {
+		p.next()
+		if err := p.parseExpr(); err != nil {
+			return err
+		}
+		if p.peek() != ")" {
+			return fmt.Errorf("expected a closing parenthesis")
+		}
+		p.next()
+		return nil
+	}
+	return p.parseComparison()
+}
+
+func (p *flowLogFilterParser) parseComparison() error {
+	name := p.next()
+	if name == "" {
+		return fmt.Errorf("expected a field name")
+	}
+	if !stringInSlice(name, availableFlowLogFields) {
+		return fmt.Errorf("unknown field %q", name)
+	}
+
+	switch op := p.next(); {
+	case op == "==" || op == "!=":
+		if !isFlowLogFilterLiteral(p.peek()) {
+			return fmt.Errorf("expected a quoted string literal after %q", op)
+		}
+		p.next()
+	case strings.EqualFold(op, "in"):
+		if p.peek() != "(" {
+			return fmt.Errorf(`expected "(" after "in"`)
+		}
+		p.next()
+		if !isFlowLogFilterLiteral(p.peek()) {
+			return fmt.Errorf("expected a quoted string literal")
+		}
+		p.next()
+		for p.peek() == "," {
+			p.next()
+			if !isFlowLogFilterLiteral(p.peek()) {
+				return fmt.Errorf("expected a quoted string literal")
+			}
+			p.next()
+		}
+		if p.peek() != ")" {
+			return fmt.Errorf(`expected ")" to close "in(...)"`)
+		}
+		p.next()
+	default:
+		return fmt.Errorf("expected a comparison operator after %q", name)
+	}
+
+	return nil
+}
+
+func isFlowLogFilterLiteral(tok string) bool {
+	return len(tok) >= 2 && (tok[0] == '\'' || tok[0] == '"') && tok[len(tok)-1] == tok[0]
+}
+
+// tokenizeFlowLogFilterExpr splits expr into the tokens consumed by flowLogFilterParser: parentheses, commas, the
+// == and != operators, quoted string literals, and otherwise whitespace-separated words.
+func tokenizeFlowLogFilterExpr(expr string) ([]string, error) {
+	var tokens []string
+
+	for i := 0; i < len(expr); {
+		switch c := expr[i]; {
+		case c == ' ' || c == '\t':
+			i++
+		case c == '(' || c == ')' || c == ',':
+			tokens = append(tokens, string(c))
+			i++
+		case c == '\'' || c == '"':
+			end := strings.IndexByte(expr[i+1:], c)
+			if end < 0 {
+				return nil, fmt.Errorf("unterminated string literal")
+			}
+			tokens = append(tokens, expr[i:i+1+end+1])
+			i += end + 2
+		case c == '=' && i+1 < len(expr) && expr[i+1] == '=':
+			tokens = append(tokens, "==")
+			i += 2
+		case c == '!' && i+1 < len(expr) && expr[i+1] == '=':
+			tokens = append(tokens, "!=")
+			i += 2
+		default:
+			start := i
+			for i < len(expr) && !strings.ContainsRune(" \t(),='\"!", rune(expr[i])) {
+				i++
+			}
+			if i == start {
+				return nil, fmt.Errorf("unexpected character %q", string(c))
+			}
+			tokens = append(tokens, expr[start:i])
+		}
+	}
+
+	return tokens, nil
+}
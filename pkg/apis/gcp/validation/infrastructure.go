@@ -0,0 +1,449 @@
+// Copyright (c) 2018 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package validation
+
+import (
+	"fmt"
+	"strings"
+
+	apisgcp "github.com/gardener/gardener-extension-provider-gcp/pkg/apis/gcp"
+
+	cidrvalidation "github.com/gardener/gardener/pkg/utils/validation/cidr"
+	apiequality "k8s.io/apimachinery/pkg/api/equality"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+// customFlowLogsMetadata is the Metadata value that enables reporting the MetadataFields allow-list.
+const customFlowLogsMetadata = "CUSTOM_METADATA"
+
+var (
+	availableAggregationIntervals = []string{
+		"INTERVAL_5_SEC",
+		"INTERVAL_30_SEC",
+		"INTERVAL_1_MIN",
+		"INTERVAL_5_MIN",
+		"INTERVAL_15_MIN",
+	}
+	availableFlowLogsMetadata = []string{
+		"INCLUDE_ALL_METADATA",
+		"CUSTOM_METADATA",
+	}
+	availableFlowLogFields = []string{
+		"src_instance",
+		"dst_instance",
+		"src_vpc",
+		"dst_vpc",
+		"src_gke_details",
+		"dst_gke_details",
+	}
+	availableIPFamilies = []string{
+		string(apisgcp.IPv4),
+		string(apisgcp.IPv6),
+	}
+)
+
+// ValidateInfrastructureConfig validates a InfrastructureConfig object.
+func ValidateInfrastructureConfig(infra *apisgcp.InfrastructureConfig, nodesCIDR, podsCIDR, servicesCIDR *string) field.ErrorList {
+	allErrs := field.ErrorList{}
+	networksPath := field.NewPath("networks")
+
+	families := infra.Networks.IPFamilies
+	if len(families) == 0 {
+		families = []apisgcp.IPFamily{apisgcp.IPv4}
+	}
+	if errs := validateIPFamilies(families, networksPath.Child("ipFamilies")); len(errs) > 0 {
+		// The CIDR checks below work family by family and are skipped while the family list itself is invalid.
+		return append(allErrs, errs...)
+	}
+
+	var internalCIDR map[apisgcp.IPFamily]cidrvalidation.CIDR
+	if infra.Networks.Internal != nil {
+		var errs field.ErrorList
+		internalCIDR, errs = splitCIDRsByFamily(*infra.Networks.Internal, families, networksPath.Child("internal"))
+		allErrs = append(allErrs, errs...)
+	}
+
+	var nodeCIDR, podCIDR, serviceCIDR map[apisgcp.IPFamily]cidrvalidation.CIDR
+	if nodesCIDR != nil {
+		var errs field.ErrorList
+		nodeCIDR, errs = splitCIDRsByFamily(*nodesCIDR, families, field.NewPath(""))
+		allErrs = append(allErrs, errs...)
+	}
+	if podsCIDR != nil {
+		var errs field.ErrorList
+		podCIDR, errs = splitCIDRsByFamily(*podsCIDR, families, field.NewPath(""))
+		allErrs = append(allErrs, errs...)
+	}
+	if servicesCIDR != nil {
+		var errs field.ErrorList
+		serviceCIDR, errs = splitCIDRsByFamily(*servicesCIDR, families, field.NewPath(""))
+		allErrs = append(allErrs, errs...)
+	}
+
+	hasWorkers, hasZones := len(infra.Networks.Workers) > 0, len(infra.Networks.Zones) > 0
+	switch {
+	case hasWorkers && hasZones:
+		allErrs = append(allErrs, field.Forbidden(networksPath, "workers and zones must not be set at the same time"))
+	case !hasWorkers && !hasZones:
+		allErrs = append(allErrs, field.Required(networksPath, "either workers or zones must be set"))
+	case hasZones:
+		allErrs = append(allErrs, validateZoneNetworks(infra.Networks.Zones, families, nodeCIDR, podCIDR, serviceCIDR, networksPath.Child("zones"))...)
+	}
+
+	var workersCIDR map[apisgcp.IPFamily]cidrvalidation.CIDR
+	if hasWorkers {
+		var errs field.ErrorList
+		workersCIDR, errs = splitCIDRsByFamily(infra.Networks.Workers, families, networksPath.Child("workers"))
+		allErrs = append(allErrs, errs...)
+
+		// Every pair among {nodes, workers, internal, pods, services} is checked for subset/overlap, family by
+		// family, so that a misconfiguration between any two of these networks is caught here instead of surfacing
+		// later as broken pod-to-service routing on the cluster.
+		allErrs = append(allErrs, validateCIDRSubsetByFamily(workersCIDR, nodeCIDR)...)
+		allErrs = append(allErrs, validateCIDROverlapByFamily(workersCIDR, podCIDR)...)
+		allErrs = append(allErrs, validateCIDROverlapByFamily(workersCIDR, serviceCIDR)...)
+	}
+
+	if internalCIDR != nil {
+		allErrs = append(allErrs, validateCIDRNotSubsetByFamily(internalCIDR, nodeCIDR)...)
+		allErrs = append(allErrs, validateCIDRNotSubsetByFamily(internalCIDR, workersCIDR)...)
+		allErrs = append(allErrs, validateCIDROverlapByFamily(internalCIDR, podCIDR)...)
+		allErrs = append(allErrs, validateCIDROverlapByFamily(internalCIDR, serviceCIDR)...)
+	}
+
+	allErrs = append(allErrs, validateCIDROverlapByFamily(nodeCIDR, podCIDR)...)
+	allErrs = append(allErrs, validateCIDROverlapByFamily(nodeCIDR, serviceCIDR)...)
+	allErrs = append(allErrs, validateCIDROverlapByFamily(podCIDR, serviceCIDR)...)
+
+	if infra.Networks.VPC != nil {
+		if len(infra.Networks.VPC.Name) == 0 {
+			allErrs = append(allErrs, field.Invalid(networksPath.Child("vpc", "name"), infra.Networks.VPC.Name, "vpc name must not be empty when vpc key is provided"))
+		}
+		if infra.Networks.VPC.CloudRouter != nil && len(infra.Networks.VPC.Name) == 0 {
+			allErrs = append(allErrs, field.Invalid(networksPath.Child("vpc", "cloudRouter"), infra.Networks.VPC.CloudRouter, "cloud router can not be configured when the VPC name is not specified"))
+		}
+	}
+
+	allErrs = append(allErrs, validateVPCFlowLogs(infra.Networks.FlowLogs, networksPath.Child("flowLogs"))...)
+
+	return allErrs
+}
+
+// ValidateInfrastructureConfigUpdate validates a InfrastructureConfig object before an update.
+func ValidateInfrastructureConfigUpdate(oldConfig, newConfig *apisgcp.InfrastructureConfig, nodesCIDR, podsCIDR, servicesCIDR *string) field.ErrorList {
+	allErrs := field.ErrorList{}
+	networksPath := field.NewPath("networks")
+
+	// Zones and IPFamilies are compared separately below so that appending a new zone or IP family is allowed;
+	// every other network setting remains wholesale immutable.
+	oldNetworks, newNetworks := oldConfig.Networks, newConfig.Networks
+	oldNetworks.Zones, newNetworks.Zones = nil, nil
+	oldNetworks.IPFamilies, newNetworks.IPFamilies = nil, nil
+
+	if !apiequality.Semantic.DeepEqual(oldNetworks, newNetworks) {
+		allErrs = append(allErrs, field.Invalid(networksPath, newConfig.Networks, "field is immutable"))
+	}
+
+	allErrs = append(allErrs, validateZoneNetworksUpdate(oldConfig.Networks.Zones, newConfig.Networks.Zones, networksPath.Child("zones"))...)
+	allErrs = append(allErrs, validateIPFamiliesUpdate(oldConfig.Networks.IPFamilies, newConfig.Networks.IPFamilies, networksPath.Child("ipFamilies"))...)
+
+	return allErrs
+}
+
+// validateZoneNetworks validates the per-zone worker and internal subnets, family by family: each must be a
+// canonical CIDR containing exactly the declared IPFamilies, a subset of the node CIDR (workers) or not a subset of
+// the node/own-zone-workers CIDR (internal), must not overlap with the pod or service CIDR, and must not overlap
+// with any other zone's worker or internal CIDR — the same cross-network checks applied to the legacy, single-CIDR
+// Workers/Internal fields.
+func validateZoneNetworks(zones []apisgcp.ZoneNetworkConfig, families []apisgcp.IPFamily, nodeCIDR, podCIDR, serviceCIDR map[apisgcp.IPFamily]cidrvalidation.CIDR, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	var workerCIDRsByZone, internalCIDRsByZone []map[apisgcp.IPFamily]cidrvalidation.CIDR
+	for i, zone := range zones {
+		zonePath := fldPath.Index(i)
+
+		if len(zone.Name) == 0 {
+			allErrs = append(allErrs, field.Required(zonePath.Child("name"), "must provide a zone name"))
+		}
+
+		workersCIDR, errs := splitCIDRsByFamily(zone.Workers, families, zonePath.Child("workers"))
+		allErrs = append(allErrs, errs...)
+		allErrs = append(allErrs, validateCIDRSubsetByFamily(workersCIDR, nodeCIDR)...)
+		allErrs = append(allErrs, validateCIDROverlapByFamily(workersCIDR, podCIDR)...)
+		allErrs = append(allErrs, validateCIDROverlapByFamily(workersCIDR, serviceCIDR)...)
+
+		for _, other := range workerCIDRsByZone {
+			allErrs = append(allErrs, validateCIDROverlapByFamily(workersCIDR, other)...)
+		}
+		for _, other := range internalCIDRsByZone {
+			allErrs = append(allErrs, validateCIDROverlapByFamily(workersCIDR, other)...)
+		}
+
+		var internalCIDR map[apisgcp.IPFamily]cidrvalidation.CIDR
+		if zone.Internal != nil {
+			var internalErrs field.ErrorList
+			internalCIDR, internalErrs = splitCIDRsByFamily(*zone.Internal, families, zonePath.Child("internal"))
+			allErrs = append(allErrs, internalErrs...)
+			allErrs = append(allErrs, validateCIDRNotSubsetByFamily(internalCIDR, nodeCIDR)...)
+			allErrs = append(allErrs, validateCIDRNotSubsetByFamily(internalCIDR, workersCIDR)...)
+			allErrs = append(allErrs, validateCIDROverlapByFamily(internalCIDR, podCIDR)...)
+			allErrs = append(allErrs, validateCIDROverlapByFamily(internalCIDR, serviceCIDR)...)
+
+			for _, other := range workerCIDRsByZone {
+				allErrs = append(allErrs, validateCIDROverlapByFamily(internalCIDR, other)...)
+			}
+			for _, other := range internalCIDRsByZone {
+				allErrs = append(allErrs, validateCIDROverlapByFamily(internalCIDR, other)...)
+			}
+		}
+
+		workerCIDRsByZone = append(workerCIDRsByZone, workersCIDR)
+		internalCIDRsByZone = append(internalCIDRsByZone, internalCIDR)
+	}
+
+	return allErrs
+}
+
+// validateZoneNetworksUpdate ensures that existing zones are neither removed nor have their CIDRs mutated, while
+// still allowing new zones to be appended.
+func validateZoneNetworksUpdate(oldZones, newZones []apisgcp.ZoneNetworkConfig, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	newZonesByName := make(map[string]apisgcp.ZoneNetworkConfig, len(newZones))
+	for _, zone := range newZones {
+		newZonesByName[zone.Name] = zone
+	}
+
+	for i, oldZone := range oldZones {
+		newZone, ok := newZonesByName[oldZone.Name]
+		if !ok {
+			allErrs = append(allErrs, field.Forbidden(fldPath.Index(i), fmt.Sprintf("zone %q must not be removed", oldZone.Name)))
+			continue
+		}
+		if !apiequality.Semantic.DeepEqual(oldZone, newZone) {
+			allErrs = append(allErrs, field.Invalid(fldPath.Index(i), newZone, fmt.Sprintf("zone %q is immutable", oldZone.Name)))
+		}
+	}
+
+	return allErrs
+}
+
+// validateIPFamilies validates that every declared IP family is supported and that no family is declared twice.
+func validateIPFamilies(ipFamilies []apisgcp.IPFamily, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	seen := map[apisgcp.IPFamily]bool{}
+	for i, family := range ipFamilies {
+		if !stringInSlice(string(family), availableIPFamilies) {
+			allErrs = append(allErrs, field.NotSupported(fldPath.Index(i), string(family), availableIPFamilies))
+			continue
+		}
+		if seen[family] {
+			allErrs = append(allErrs, field.Duplicate(fldPath.Index(i), family))
+		}
+		seen[family] = true
+	}
+
+	return allErrs
+}
+
+// validateIPFamiliesUpdate ensures that already declared IP families are neither removed nor reordered, while
+// still allowing new families to be appended.
+func validateIPFamiliesUpdate(oldFamilies, newFamilies []apisgcp.IPFamily, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	for i, family := range oldFamilies {
+		if i >= len(newFamilies) || newFamilies[i] != family {
+			allErrs = append(allErrs, field.Invalid(fldPath, newFamilies, "existing IP families must not be removed or reordered"))
+			break
+		}
+	}
+
+	return allErrs
+}
+
+// splitCIDRsByFamily parses value, which may be a single CIDR or a comma-separated IPv4+IPv6 pair, into a map of
+// IP family to the corresponding CIDR. It validates that value contains exactly one CIDR for every family declared
+// in families and none for any other family.
+func splitCIDRsByFamily(value string, families []apisgcp.IPFamily, fldPath *field.Path) (map[apisgcp.IPFamily]cidrvalidation.CIDR, field.ErrorList) {
+	allErrs := field.ErrorList{}
+	result := map[apisgcp.IPFamily]cidrvalidation.CIDR{}
+
+	for _, part := range strings.Split(value, ",") {
+		part = strings.TrimSpace(part)
+
+		cidr := cidrvalidation.NewCIDR(part, fldPath)
+		allErrs = append(allErrs, cidr.ValidateParse()...)
+		allErrs = append(allErrs, cidrvalidation.ValidateCIDRIsCanonical(fldPath, part)...)
+
+		family := cidrFamily(part)
+		if _, ok := result[family]; ok {
+			allErrs = append(allErrs, field.Invalid(fldPath, value, fmt.Sprintf("must not specify the %s family more than once", family)))
+			continue
+		}
+		result[family] = cidr
+	}
+
+	for _, family := range families {
+		if _, ok := result[family]; !ok {
+			allErrs = append(allErrs, field.Required(fldPath, fmt.Sprintf("must contain a CIDR for the %s family declared in networks.ipFamilies", family)))
+		}
+	}
+	for family := range result {
+		if !familyInSlice(family, families) {
+			allErrs = append(allErrs, field.Invalid(fldPath, value, fmt.Sprintf("must not contain a CIDR for the %s family, which is not declared in networks.ipFamilies", family)))
+		}
+	}
+
+	return result, allErrs
+}
+
+// cidrFamily returns the IP family of a CIDR string, determined by the presence of a colon.
+func cidrFamily(cidr string) apisgcp.IPFamily {
+	if strings.Contains(cidr, ":") {
+		return apisgcp.IPv6
+	}
+	return apisgcp.IPv4
+}
+
+func familyInSlice(family apisgcp.IPFamily, families []apisgcp.IPFamily) bool {
+	for _, f := range families {
+		if f == family {
+			return true
+		}
+	}
+	return false
+}
+
+func validateVPCFlowLogs(flowLogs *apisgcp.FlowLogs, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+	if flowLogs == nil {
+		return allErrs
+	}
+
+	if flowLogs.AggregationInterval == nil && flowLogs.FlowSampling == nil && flowLogs.Metadata == nil && flowLogs.FilterExpr == nil && len(flowLogs.MetadataFields) == 0 {
+		allErrs = append(allErrs, field.Required(fldPath, "at least one VPC flow log parameter must be specified when VPC flow log section is provided"))
+		return allErrs
+	}
+
+	if flowLogs.AggregationInterval != nil && !stringInSlice(*flowLogs.AggregationInterval, availableAggregationIntervals) {
+		allErrs = append(allErrs, field.NotSupported(fldPath.Child("aggregationInterval"), *flowLogs.AggregationInterval, availableAggregationIntervals))
+	}
+
+	if flowLogs.Metadata != nil && !stringInSlice(*flowLogs.Metadata, availableFlowLogsMetadata) {
+		allErrs = append(allErrs, field.NotSupported(fldPath.Child("metadata"), *flowLogs.Metadata, availableFlowLogsMetadata))
+	}
+
+	allErrs = append(allErrs, validateFlowLogMetadataFields(flowLogs, fldPath.Child("metadataFields"))...)
+
+	if flowLogs.FlowSampling != nil && (*flowLogs.FlowSampling < 0 || *flowLogs.FlowSampling > 1) {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("flowSampling"), *flowLogs.FlowSampling, "must contain a valid value"))
+	}
+
+	if flowLogs.FilterExpr != nil {
+		allErrs = append(allErrs, validateFlowLogFilterExpr(*flowLogs.FilterExpr, fldPath.Child("filterExpr"))...)
+	}
+
+	return allErrs
+}
+
+// validateFlowLogMetadataFields validates that MetadataFields is only set, and non-empty, when Metadata is
+// "CUSTOM_METADATA", and that every listed field is part of the known allow-set.
+func validateFlowLogMetadataFields(flowLogs *apisgcp.FlowLogs, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	isCustomMetadata := flowLogs.Metadata != nil && *flowLogs.Metadata == customFlowLogsMetadata
+	if !isCustomMetadata {
+		if len(flowLogs.MetadataFields) > 0 {
+			allErrs = append(allErrs, field.Forbidden(fldPath, fmt.Sprintf("must not be set unless metadata is set to %q", customFlowLogsMetadata)))
+		}
+		return allErrs
+	}
+
+	if len(flowLogs.MetadataFields) == 0 {
+		allErrs = append(allErrs, field.Required(fldPath, fmt.Sprintf("must specify at least one metadata field when metadata is set to %q", customFlowLogsMetadata)))
+	}
+	for i, metadataField := range flowLogs.MetadataFields {
+		if !stringInSlice(metadataField, availableFlowLogFields) {
+			allErrs = append(allErrs, field.NotSupported(fldPath.Index(i), metadataField, availableFlowLogFields))
+		}
+	}
+
+	return allErrs
+}
+
+// validateCIDRSubset validates that sub is a subset of super. Either argument may be nil (e.g. because the
+// caller did not supply that network), in which case no error is returned. Errors are reported on sub's field path.
+func validateCIDRSubset(sub, super cidrvalidation.CIDR) field.ErrorList {
+	if sub == nil || super == nil {
+		return nil
+	}
+	return super.ValidateSubset(sub)
+}
+
+// validateCIDRNotSubset validates that sub is not a subset of super. Either argument may be nil, in which case
+// no error is returned. Errors are reported on sub's field path.
+func validateCIDRNotSubset(sub, super cidrvalidation.CIDR) field.ErrorList {
+	if sub == nil || super == nil {
+		return nil
+	}
+	return super.ValidateNotSubset(sub)
+}
+
+// validateCIDROverlap validates that a and b do not overlap. Either argument may be nil, in which case no error
+// is returned. Errors are reported on a's field path.
+func validateCIDROverlap(a, b cidrvalidation.CIDR) field.ErrorList {
+	if a == nil || b == nil {
+		return nil
+	}
+	return b.ValidateNotOverlap(a)
+}
+
+// validateCIDRSubsetByFamily validates, family by family, that sub is a subset of super. A family missing from
+// either map is skipped, e.g. because the corresponding network does not declare that family.
+func validateCIDRSubsetByFamily(sub, super map[apisgcp.IPFamily]cidrvalidation.CIDR) field.ErrorList {
+	allErrs := field.ErrorList{}
+	for _, family := range []apisgcp.IPFamily{apisgcp.IPv4, apisgcp.IPv6} {
+		allErrs = append(allErrs, validateCIDRSubset(sub[family], super[family])...)
+	}
+	return allErrs
+}
+
+// validateCIDRNotSubsetByFamily validates, family by family, that sub is not a subset of super.
+func validateCIDRNotSubsetByFamily(sub, super map[apisgcp.IPFamily]cidrvalidation.CIDR) field.ErrorList {
+	allErrs := field.ErrorList{}
+	for _, family := range []apisgcp.IPFamily{apisgcp.IPv4, apisgcp.IPv6} {
+		allErrs = append(allErrs, validateCIDRNotSubset(sub[family], super[family])...)
+	}
+	return allErrs
+}
+
+// validateCIDROverlapByFamily validates, family by family, that a and b do not overlap.
+func validateCIDROverlapByFamily(a, b map[apisgcp.IPFamily]cidrvalidation.CIDR) field.ErrorList {
+	allErrs := field.ErrorList{}
+	for _, family := range []apisgcp.IPFamily{apisgcp.IPv4, apisgcp.IPv6} {
+		allErrs = append(allErrs, validateCIDROverlap(a[family], b[family])...)
+	}
+	return allErrs
+}
+
+func stringInSlice(value string, list []string) bool {
+	for _, v := range list {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
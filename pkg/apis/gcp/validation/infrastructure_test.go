@@ -15,6 +15,8 @@
 package validation_test
 
 import (
+	"strings"
+
 	apisgcp "github.com/gardener/gardener-extension-provider-gcp/pkg/apis/gcp"
 	. "github.com/gardener/gardener-extension-provider-gcp/pkg/apis/gcp/validation"
 
@@ -115,7 +117,7 @@ var _ = Describe("InfrastructureConfig validation", func() {
 
 				errorList := ValidateInfrastructureConfig(infrastructureConfig, &nodeCIDR, &podCIDR, &serviceCIDR)
 
-				Expect(errorList).To(HaveLen(2))
+				Expect(errorList).To(HaveLen(5))
 				Expect(errorList).To(ConsistOfFields(Fields{
 					"Type":   Equal(field.ErrorTypeInvalid),
 					"Field":  Equal("networks.internal"),
@@ -124,6 +126,18 @@ var _ = Describe("InfrastructureConfig validation", func() {
 					"Type":   Equal(field.ErrorTypeInvalid),
 					"Field":  Equal("networks.workers"),
 					"Detail": Equal("must be valid canonical CIDR"),
+				}, Fields{
+					"Type":   Equal(field.ErrorTypeInvalid),
+					"Field":  Equal(""),
+					"Detail": Equal("must be valid canonical CIDR"),
+				}, Fields{
+					"Type":   Equal(field.ErrorTypeInvalid),
+					"Field":  Equal(""),
+					"Detail": Equal("must be valid canonical CIDR"),
+				}, Fields{
+					"Type":   Equal(field.ErrorTypeInvalid),
+					"Field":  Equal(""),
+					"Detail": Equal("must be valid canonical CIDR"),
 				}))
 			})
 			It("should forbid configuring CloudRouter if VPC name is not set", func() {
@@ -165,7 +179,7 @@ var _ = Describe("InfrastructureConfig validation", func() {
 				}, Fields{
 					"Type":   Equal(field.ErrorTypeNotSupported),
 					"Field":  Equal("networks.flowLogs.metadata"),
-					"Detail": Equal("supported values: \"INCLUDE_ALL_METADATA\""),
+					"Detail": Equal("supported values: \"INCLUDE_ALL_METADATA\", \"CUSTOM_METADATA\""),
 				}, Fields{
 					"Type":   Equal(field.ErrorTypeInvalid),
 					"Field":  Equal("networks.flowLogs.flowSampling"),
@@ -181,6 +195,484 @@ var _ = Describe("InfrastructureConfig validation", func() {
 				errorList := ValidateInfrastructureConfig(infrastructureConfig, &nodes, &pods, &services)
 				Expect(errorList).To(BeEmpty())
 			})
+
+			It("should require metadataFields when metadata is CUSTOM_METADATA", func() {
+				metadata := "CUSTOM_METADATA"
+				infrastructureConfig.Networks.FlowLogs = &apisgcp.FlowLogs{Metadata: &metadata}
+
+				errorList := ValidateInfrastructureConfig(infrastructureConfig, &nodes, &pods, &services)
+				Expect(errorList).To(ConsistOfFields(Fields{
+					"Type":   Equal(field.ErrorTypeRequired),
+					"Field":  Equal("networks.flowLogs.metadataFields"),
+					"Detail": Equal(`must specify at least one metadata field when metadata is set to "CUSTOM_METADATA"`),
+				}))
+			})
+
+			It("should forbid an unsupported metadata field", func() {
+				metadata := "CUSTOM_METADATA"
+				infrastructureConfig.Networks.FlowLogs = &apisgcp.FlowLogs{Metadata: &metadata, MetadataFields: []string{"src_instance", "bogus_field"}}
+
+				errorList := ValidateInfrastructureConfig(infrastructureConfig, &nodes, &pods, &services)
+				Expect(errorList).To(ConsistOfFields(Fields{
+					"Type":   Equal(field.ErrorTypeNotSupported),
+					"Field":  Equal("networks.flowLogs.metadataFields[1]"),
+					"Detail": Equal(`supported values: "src_instance", "dst_instance", "src_vpc", "dst_vpc", "src_gke_details", "dst_gke_details"`),
+				}))
+			})
+
+			It("should forbid metadataFields when metadata is not CUSTOM_METADATA", func() {
+				metadata := "INCLUDE_ALL_METADATA"
+				infrastructureConfig.Networks.FlowLogs = &apisgcp.FlowLogs{Metadata: &metadata, MetadataFields: []string{"src_instance"}}
+
+				errorList := ValidateInfrastructureConfig(infrastructureConfig, &nodes, &pods, &services)
+				Expect(errorList).To(ConsistOfFields(Fields{
+					"Type":   Equal(field.ErrorTypeForbidden),
+					"Field":  Equal("networks.flowLogs.metadataFields"),
+					"Detail": Equal(`must not be set unless metadata is set to "CUSTOM_METADATA"`),
+				}))
+			})
+
+			It("should allow a valid CUSTOM_METADATA configuration", func() {
+				metadata := "CUSTOM_METADATA"
+				infrastructureConfig.Networks.FlowLogs = &apisgcp.FlowLogs{Metadata: &metadata, MetadataFields: []string{"src_instance", "dst_vpc"}}
+
+				errorList := ValidateInfrastructureConfig(infrastructureConfig, &nodes, &pods, &services)
+				Expect(errorList).To(BeEmpty())
+			})
+
+			It("should allow a valid filter expression", func() {
+				filterExpr := `src_vpc == "default" AND NOT (dst_vpc == "other" OR dst_vpc in ("a", "b"))`
+				infrastructureConfig.Networks.FlowLogs = &apisgcp.FlowLogs{FilterExpr: &filterExpr}
+
+				errorList := ValidateInfrastructureConfig(infrastructureConfig, &nodes, &pods, &services)
+				Expect(errorList).To(BeEmpty())
+			})
+
+			It("should forbid a filter expression with unbalanced parentheses", func() {
+				filterExpr := `(src_vpc == "default"`
+				infrastructureConfig.Networks.FlowLogs = &apisgcp.FlowLogs{FilterExpr: &filterExpr}
+
+				errorList := ValidateInfrastructureConfig(infrastructureConfig, &nodes, &pods, &services)
+				Expect(errorList).To(ConsistOfFields(Fields{
+					"Type":   Equal(field.ErrorTypeInvalid),
+					"Field":  Equal("networks.flowLogs.filterExpr"),
+					"Detail": Equal("expected a closing parenthesis"),
+				}))
+			})
+
+			It("should forbid a filter expression referencing an unknown field", func() {
+				filterExpr := `bogus_field == "default"`
+				infrastructureConfig.Networks.FlowLogs = &apisgcp.FlowLogs{FilterExpr: &filterExpr}
+
+				errorList := ValidateInfrastructureConfig(infrastructureConfig, &nodes, &pods, &services)
+				Expect(errorList).To(ConsistOfFields(Fields{
+					"Type":   Equal(field.ErrorTypeInvalid),
+					"Field":  Equal("networks.flowLogs.filterExpr"),
+					"Detail": Equal(`unknown field "bogus_field"`),
+				}))
+			})
+
+			It("should forbid a filter expression exceeding the maximum length", func() {
+				filterExpr := strings.Repeat("(", 2000)
+				infrastructureConfig.Networks.FlowLogs = &apisgcp.FlowLogs{FilterExpr: &filterExpr}
+
+				errorList := ValidateInfrastructureConfig(infrastructureConfig, &nodes, &pods, &services)
+				Expect(errorList).To(ConsistOfFields(Fields{
+					"Type":   Equal(field.ErrorTypeInvalid),
+					"Field":  Equal("networks.flowLogs.filterExpr"),
+					"Detail": Equal("must not exceed 1024 characters"),
+				}))
+			})
+
+			It("should forbid a filter expression nested beyond the maximum depth", func() {
+				filterExpr := strings.Repeat("NOT ", 100) + `src_vpc == "default"`
+				infrastructureConfig.Networks.FlowLogs = &apisgcp.FlowLogs{FilterExpr: &filterExpr}
+
+				errorList := ValidateInfrastructureConfig(infrastructureConfig, &nodes, &pods, &services)
+				Expect(errorList).To(ConsistOfFields(Fields{
+					"Type":   Equal(field.ErrorTypeInvalid),
+					"Field":  Equal("networks.flowLogs.filterExpr"),
+					"Detail": Equal("expression nesting exceeds the maximum depth of 32"),
+				}))
+			})
+		})
+
+		Context("cross-network overlap", func() {
+			It("should forbid the pod CIDR overlapping with the node and worker CIDR", func() {
+				overlappingPods := "10.250.0.0/20"
+
+				errorList := ValidateInfrastructureConfig(infrastructureConfig, &nodes, &overlappingPods, &services)
+
+				Expect(errorList).To(ConsistOfFields(Fields{
+					"Type":   Equal(field.ErrorTypeInvalid),
+					"Field":  Equal(""),
+					"Detail": Equal(`must not overlap with "" ("10.250.0.0/20")`),
+				}, Fields{
+					"Type":   Equal(field.ErrorTypeInvalid),
+					"Field":  Equal("networks.workers"),
+					"Detail": Equal(`must not overlap with "" ("10.250.0.0/20")`),
+				}))
+			})
+
+			It("should forbid the service CIDR overlapping with the node and worker CIDR", func() {
+				overlappingServices := "10.250.0.0/20"
+
+				errorList := ValidateInfrastructureConfig(infrastructureConfig, &nodes, &pods, &overlappingServices)
+
+				Expect(errorList).To(ConsistOfFields(Fields{
+					"Type":   Equal(field.ErrorTypeInvalid),
+					"Field":  Equal(""),
+					"Detail": Equal(`must not overlap with "" ("10.250.0.0/20")`),
+				}, Fields{
+					"Type":   Equal(field.ErrorTypeInvalid),
+					"Field":  Equal("networks.workers"),
+					"Detail": Equal(`must not overlap with "" ("10.250.0.0/20")`),
+				}))
+			})
+
+			It("should forbid the pod CIDR overlapping with the service CIDR", func() {
+				overlappingServices := pods
+
+				errorList := ValidateInfrastructureConfig(infrastructureConfig, &nodes, &pods, &overlappingServices)
+
+				Expect(errorList).To(ConsistOfFields(Fields{
+					"Type":   Equal(field.ErrorTypeInvalid),
+					"Field":  Equal(""),
+					"Detail": Equal(`must not overlap with "" ("100.96.0.0/11")`),
+				}))
+			})
+
+			It("should forbid reusing the same CIDR for the pod and service networks", func() {
+				sameCIDR := "100.96.0.0/11"
+
+				errorList := ValidateInfrastructureConfig(infrastructureConfig, &nodes, &sameCIDR, &sameCIDR)
+
+				Expect(errorList).To(ConsistOfFields(Fields{
+					"Type":   Equal(field.ErrorTypeInvalid),
+					"Field":  Equal(""),
+					"Detail": Equal(`must not overlap with "" ("100.96.0.0/11")`),
+				}))
+			})
+		})
+
+		Context("zones", func() {
+			BeforeEach(func() {
+				infrastructureConfig.Networks.Workers = ""
+				infrastructureConfig.Networks.Zones = []apisgcp.ZoneNetworkConfig{
+					{Name: "eu-west1-a", Workers: "10.250.0.0/17"},
+					{Name: "eu-west1-b", Workers: "10.250.128.0/17"},
+				}
+			})
+
+			It("should allow a valid multi-zone configuration", func() {
+				errorList := ValidateInfrastructureConfig(infrastructureConfig, &nodes, &pods, &services)
+
+				Expect(errorList).To(BeEmpty())
+			})
+
+			It("should forbid setting both workers and zones", func() {
+				infrastructureConfig.Networks.Workers = "10.250.0.0/16"
+
+				errorList := ValidateInfrastructureConfig(infrastructureConfig, &nodes, &pods, &services)
+
+				Expect(errorList).To(ConsistOfFields(Fields{
+					"Type":   Equal(field.ErrorTypeForbidden),
+					"Field":  Equal("networks"),
+					"Detail": Equal("workers and zones must not be set at the same time"),
+				}))
+			})
+
+			It("should forbid neither workers nor zones being set", func() {
+				infrastructureConfig.Networks.Zones = nil
+
+				errorList := ValidateInfrastructureConfig(infrastructureConfig, &nodes, &pods, &services)
+
+				Expect(errorList).To(ConsistOfFields(Fields{
+					"Type":   Equal(field.ErrorTypeRequired),
+					"Field":  Equal("networks"),
+					"Detail": Equal("either workers or zones must be set"),
+				}))
+			})
+
+			It("should forbid zone worker CIDRs overlapping with each other", func() {
+				infrastructureConfig.Networks.Zones[1].Workers = "10.250.0.0/18"
+
+				errorList := ValidateInfrastructureConfig(infrastructureConfig, &nodes, &pods, &services)
+
+				Expect(errorList).To(ConsistOfFields(Fields{
+					"Type":   Equal(field.ErrorTypeInvalid),
+					"Field":  Equal("networks.zones[1].workers"),
+					"Detail": Equal(`must not overlap with "networks.zones[0].workers" ("10.250.0.0/17")`),
+				}))
+			})
+
+			It("should forbid a zone worker CIDR that is not a subset of the node CIDR", func() {
+				infrastructureConfig.Networks.Zones[1].Workers = "1.1.1.0/24"
+
+				errorList := ValidateInfrastructureConfig(infrastructureConfig, &nodes, &pods, &services)
+
+				Expect(errorList).To(ConsistOfFields(Fields{
+					"Type":   Equal(field.ErrorTypeInvalid),
+					"Field":  Equal("networks.zones[1].workers"),
+					"Detail": Equal(`must be a subset of "" ("10.250.0.0/16")`),
+				}))
+			})
+
+			It("should forbid a zone worker CIDR overlapping with the pod CIDR", func() {
+				infrastructureConfig.Networks.Zones[0].Workers = pods
+
+				errorList := ValidateInfrastructureConfig(infrastructureConfig, &nodes, &pods, &services)
+
+				Expect(errorList).To(ContainElement(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("networks.zones[0].workers"),
+				})))
+			})
+
+			It("should forbid a zone worker CIDR overlapping with the service CIDR", func() {
+				infrastructureConfig.Networks.Zones[0].Workers = services
+
+				errorList := ValidateInfrastructureConfig(infrastructureConfig, &nodes, &pods, &services)
+
+				Expect(errorList).To(ContainElement(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("networks.zones[0].workers"),
+				})))
+			})
+
+			It("should forbid a zone internal CIDR overlapping with the pod CIDR", func() {
+				zoneInternal := pods
+				infrastructureConfig.Networks.Zones[0].Internal = &zoneInternal
+
+				errorList := ValidateInfrastructureConfig(infrastructureConfig, &nodes, &pods, &services)
+
+				Expect(errorList).To(ContainElement(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("networks.zones[0].internal"),
+				})))
+			})
+		})
+
+		Context("dual-stack", func() {
+			var (
+				nodesIPv6    = "2001:db8:10::/48"
+				workersIPv6  = "2001:db8:10::/64"
+				podsIPv6     = "2001:db8:20::/48"
+				servicesIPv6 = "2001:db8:30::/48"
+				internalIPv6 = "2001:db8:40::/64"
+			)
+
+			It("should allow an explicit IPv4-only configuration", func() {
+				infrastructureConfig.Networks.IPFamilies = []apisgcp.IPFamily{apisgcp.IPv4}
+
+				errorList := ValidateInfrastructureConfig(infrastructureConfig, &nodes, &pods, &services)
+
+				Expect(errorList).To(BeEmpty())
+			})
+
+			It("should allow a valid IPv6-only configuration", func() {
+				infrastructureConfig.Networks.IPFamilies = []apisgcp.IPFamily{apisgcp.IPv6}
+				infrastructureConfig.Networks.Internal = &internalIPv6
+				infrastructureConfig.Networks.Workers = workersIPv6
+
+				errorList := ValidateInfrastructureConfig(infrastructureConfig, &nodesIPv6, &podsIPv6, &servicesIPv6)
+
+				Expect(errorList).To(BeEmpty())
+			})
+
+			It("should allow a valid dual-stack configuration", func() {
+				infrastructureConfig.Networks.IPFamilies = []apisgcp.IPFamily{apisgcp.IPv4, apisgcp.IPv6}
+				dualInternal := internal + "," + internalIPv6
+				infrastructureConfig.Networks.Internal = &dualInternal
+				infrastructureConfig.Networks.Workers = nodes + "," + workersIPv6
+
+				dualNodes, dualPods, dualServices := nodes+","+nodesIPv6, pods+","+podsIPv6, services+","+servicesIPv6
+				errorList := ValidateInfrastructureConfig(infrastructureConfig, &dualNodes, &dualPods, &dualServices)
+
+				Expect(errorList).To(BeEmpty())
+			})
+
+			It("should forbid a field missing the CIDR of a declared IP family", func() {
+				infrastructureConfig.Networks.IPFamilies = []apisgcp.IPFamily{apisgcp.IPv4, apisgcp.IPv6}
+				infrastructureConfig.Networks.Internal = nil
+
+				errorList := ValidateInfrastructureConfig(infrastructureConfig, nil, nil, nil)
+
+				Expect(errorList).To(ConsistOfFields(Fields{
+					"Type":   Equal(field.ErrorTypeRequired),
+					"Field":  Equal("networks.workers"),
+					"Detail": Equal("must contain a CIDR for the IPv6 family declared in networks.ipFamilies"),
+				}))
+			})
+
+			It("should forbid declaring the same IP family twice in a single field", func() {
+				infrastructureConfig.Networks.Internal = nil
+				infrastructureConfig.Networks.Workers = nodes + ",10.251.0.0/16"
+
+				errorList := ValidateInfrastructureConfig(infrastructureConfig, nil, nil, nil)
+
+				Expect(errorList).To(ConsistOfFields(Fields{
+					"Type":   Equal(field.ErrorTypeInvalid),
+					"Field":  Equal("networks.workers"),
+					"Detail": Equal("must not specify the IPv4 family more than once"),
+				}))
+			})
+
+			It("should forbid a CIDR of an IP family that is not declared", func() {
+				infrastructureConfig.Networks.Internal = nil
+				infrastructureConfig.Networks.Workers = nodes + "," + workersIPv6
+
+				errorList := ValidateInfrastructureConfig(infrastructureConfig, nil, nil, nil)
+
+				Expect(errorList).To(ConsistOfFields(Fields{
+					"Type":   Equal(field.ErrorTypeInvalid),
+					"Field":  Equal("networks.workers"),
+					"Detail": Equal("must not contain a CIDR for the IPv6 family, which is not declared in networks.ipFamilies"),
+				}))
+			})
+
+			It("should forbid an unsupported IP family", func() {
+				infrastructureConfig.Networks.Internal = nil
+				infrastructureConfig.Networks.Workers = ""
+				infrastructureConfig.Networks.Zones = []apisgcp.ZoneNetworkConfig{{Name: "eu-west1-a", Workers: "10.250.0.0/17"}}
+				infrastructureConfig.Networks.IPFamilies = []apisgcp.IPFamily{"bogus"}
+
+				errorList := ValidateInfrastructureConfig(infrastructureConfig, nil, nil, nil)
+
+				Expect(errorList).To(ConsistOfFields(Fields{
+					"Type":   Equal(field.ErrorTypeNotSupported),
+					"Field":  Equal("networks.ipFamilies[0]"),
+					"Detail": Equal(`supported values: "IPv4", "IPv6"`),
+				}))
+			})
+
+			It("should forbid declaring the same IP family twice in networks.ipFamilies", func() {
+				infrastructureConfig.Networks.Internal = nil
+				infrastructureConfig.Networks.Workers = ""
+				infrastructureConfig.Networks.Zones = []apisgcp.ZoneNetworkConfig{{Name: "eu-west1-a", Workers: "10.250.0.0/17"}}
+				infrastructureConfig.Networks.IPFamilies = []apisgcp.IPFamily{apisgcp.IPv4, apisgcp.IPv4}
+
+				errorList := ValidateInfrastructureConfig(infrastructureConfig, nil, nil, nil)
+
+				Expect(errorList).To(ConsistOfFields(Fields{
+					"Type":  Equal(field.ErrorTypeDuplicate),
+					"Field": Equal("networks.ipFamilies[1]"),
+				}))
+			})
+
+			Context("zones", func() {
+				BeforeEach(func() {
+					infrastructureConfig.Networks.Workers = ""
+				})
+
+				It("should allow a valid IPv6-only multi-zone configuration", func() {
+					infrastructureConfig.Networks.IPFamilies = []apisgcp.IPFamily{apisgcp.IPv6}
+					infrastructureConfig.Networks.Internal = nil
+					infrastructureConfig.Networks.Zones = []apisgcp.ZoneNetworkConfig{
+						{Name: "eu-west1-a", Workers: "2001:db8:10:1::/64"},
+						{Name: "eu-west1-b", Workers: "2001:db8:10:2::/64"},
+					}
+
+					errorList := ValidateInfrastructureConfig(infrastructureConfig, &nodesIPv6, &podsIPv6, &servicesIPv6)
+
+					Expect(errorList).To(BeEmpty())
+				})
+
+				It("should forbid an IPv4 zone worker CIDR when only IPv6 is declared", func() {
+					infrastructureConfig.Networks.IPFamilies = []apisgcp.IPFamily{apisgcp.IPv6}
+					infrastructureConfig.Networks.Internal = nil
+					infrastructureConfig.Networks.Zones = []apisgcp.ZoneNetworkConfig{
+						{Name: "eu-west1-a", Workers: "10.250.0.0/17"},
+					}
+
+					errorList := ValidateInfrastructureConfig(infrastructureConfig, &nodesIPv6, &podsIPv6, &servicesIPv6)
+
+					Expect(errorList).To(ContainElement(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeRequired),
+						"Field": Equal("networks.zones[0].workers"),
+					})))
+				})
+
+				It("should forbid an IPv6 zone worker CIDR overlapping with the IPv6 pod CIDR", func() {
+					infrastructureConfig.Networks.IPFamilies = []apisgcp.IPFamily{apisgcp.IPv6}
+					infrastructureConfig.Networks.Internal = nil
+					infrastructureConfig.Networks.Zones = []apisgcp.ZoneNetworkConfig{
+						{Name: "eu-west1-a", Workers: podsIPv6},
+					}
+
+					errorList := ValidateInfrastructureConfig(infrastructureConfig, &nodesIPv6, &podsIPv6, &servicesIPv6)
+
+					Expect(errorList).To(ContainElement(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("networks.zones[0].workers"),
+					})))
+				})
+
+				It("should allow a valid dual-stack multi-zone configuration", func() {
+					infrastructureConfig.Networks.IPFamilies = []apisgcp.IPFamily{apisgcp.IPv4, apisgcp.IPv6}
+					infrastructureConfig.Networks.Internal = nil
+					infrastructureConfig.Networks.Zones = []apisgcp.ZoneNetworkConfig{
+						{Name: "eu-west1-a", Workers: "10.250.0.0/17," + "2001:db8:10:1::/64"},
+						{Name: "eu-west1-b", Workers: "10.250.128.0/17," + "2001:db8:10:2::/64"},
+					}
+
+					dualNodes, dualPods, dualServices := nodes+","+nodesIPv6, pods+","+podsIPv6, services+","+servicesIPv6
+					errorList := ValidateInfrastructureConfig(infrastructureConfig, &dualNodes, &dualPods, &dualServices)
+
+					Expect(errorList).To(BeEmpty())
+				})
+
+				It("should forbid dual-stack zone worker CIDRs whose IPv6 parts overlap with each other", func() {
+					infrastructureConfig.Networks.IPFamilies = []apisgcp.IPFamily{apisgcp.IPv4, apisgcp.IPv6}
+					infrastructureConfig.Networks.Internal = nil
+					infrastructureConfig.Networks.Zones = []apisgcp.ZoneNetworkConfig{
+						{Name: "eu-west1-a", Workers: "10.250.0.0/17," + "2001:db8:10::/64"},
+						{Name: "eu-west1-b", Workers: "10.250.128.0/17," + "2001:db8:10::/65"},
+					}
+
+					dualNodes, dualPods, dualServices := nodes+","+nodesIPv6, pods+","+podsIPv6, services+","+servicesIPv6
+					errorList := ValidateInfrastructureConfig(infrastructureConfig, &dualNodes, &dualPods, &dualServices)
+
+					Expect(errorList).To(ContainElement(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("networks.zones[1].workers"),
+					})))
+				})
+
+				It("should forbid a zone's internal CIDR that overlaps with that same zone's workers CIDR", func() {
+					infrastructureConfig.Networks.IPFamilies = []apisgcp.IPFamily{apisgcp.IPv6}
+					infrastructureConfig.Networks.Internal = nil
+					workers := "2001:db8:10:1::/64"
+					infrastructureConfig.Networks.Zones = []apisgcp.ZoneNetworkConfig{
+						{Name: "eu-west1-a", Workers: workers, Internal: &workers},
+					}
+
+					errorList := ValidateInfrastructureConfig(infrastructureConfig, &nodesIPv6, &podsIPv6, &servicesIPv6)
+
+					Expect(errorList).To(ContainElement(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("networks.zones[0].internal"),
+					})))
+				})
+
+				It("should forbid a zone's internal CIDR that overlaps with another zone's workers CIDR", func() {
+					infrastructureConfig.Networks.IPFamilies = []apisgcp.IPFamily{apisgcp.IPv6}
+					infrastructureConfig.Networks.Internal = nil
+					otherWorkers := "2001:db8:10:2::/64"
+					infrastructureConfig.Networks.Zones = []apisgcp.ZoneNetworkConfig{
+						{Name: "eu-west1-a", Workers: "2001:db8:10:1::/64", Internal: &otherWorkers},
+						{Name: "eu-west1-b", Workers: otherWorkers},
+					}
+
+					errorList := ValidateInfrastructureConfig(infrastructureConfig, &nodesIPv6, &podsIPv6, &servicesIPv6)
+
+					Expect(errorList).To(ContainElement(MatchFields(IgnoreExtras, Fields{
+						"Type":   Equal(field.ErrorTypeInvalid),
+						"Field":  Equal("networks.zones[1].workers"),
+						"Detail": Equal(`must not overlap with "networks.zones[0].internal" ("2001:db8:10:2::/64")`),
+					})))
+				})
+			})
 		})
 	})
 
@@ -200,5 +692,89 @@ var _ = Describe("InfrastructureConfig validation", func() {
 				"Field": Equal("networks"),
 			}))))
 		})
+
+		Context("zones", func() {
+			BeforeEach(func() {
+				infrastructureConfig.Networks.Workers = ""
+				infrastructureConfig.Networks.Zones = []apisgcp.ZoneNetworkConfig{
+					{Name: "eu-west1-a", Workers: "10.250.0.0/17"},
+					{Name: "eu-west1-b", Workers: "10.250.128.0/17"},
+				}
+			})
+
+			It("should allow appending a new zone", func() {
+				newInfrastructureConfig := infrastructureConfig.DeepCopy()
+				newInfrastructureConfig.Networks.Zones = append(newInfrastructureConfig.Networks.Zones, apisgcp.ZoneNetworkConfig{Name: "eu-west1-c", Workers: "10.250.192.0/18"})
+
+				errorList := ValidateInfrastructureConfigUpdate(infrastructureConfig, newInfrastructureConfig, &nodes, &pods, &services)
+
+				Expect(errorList).To(BeEmpty())
+			})
+
+			It("should forbid shrinking the zone list", func() {
+				newInfrastructureConfig := infrastructureConfig.DeepCopy()
+				newInfrastructureConfig.Networks.Zones = newInfrastructureConfig.Networks.Zones[:1]
+
+				errorList := ValidateInfrastructureConfigUpdate(infrastructureConfig, newInfrastructureConfig, &nodes, &pods, &services)
+
+				Expect(errorList).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeForbidden),
+					"Field": Equal("networks.zones[1]"),
+				}))))
+			})
+
+			It("should forbid mutating the CIDR of an existing zone", func() {
+				newInfrastructureConfig := infrastructureConfig.DeepCopy()
+				newInfrastructureConfig.Networks.Zones[1].Workers = "10.250.192.0/18"
+
+				errorList := ValidateInfrastructureConfigUpdate(infrastructureConfig, newInfrastructureConfig, &nodes, &pods, &services)
+
+				Expect(errorList).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("networks.zones[1]"),
+				}))))
+			})
+		})
+
+		Context("IP families", func() {
+			BeforeEach(func() {
+				infrastructureConfig.Networks.IPFamilies = []apisgcp.IPFamily{apisgcp.IPv4}
+			})
+
+			It("should allow appending a new IP family", func() {
+				newInfrastructureConfig := infrastructureConfig.DeepCopy()
+				newInfrastructureConfig.Networks.IPFamilies = append(newInfrastructureConfig.Networks.IPFamilies, apisgcp.IPv6)
+
+				errorList := ValidateInfrastructureConfigUpdate(infrastructureConfig, newInfrastructureConfig, &nodes, &pods, &services)
+
+				Expect(errorList).To(BeEmpty())
+			})
+
+			It("should forbid removing an existing IP family", func() {
+				infrastructureConfig.Networks.IPFamilies = []apisgcp.IPFamily{apisgcp.IPv4, apisgcp.IPv6}
+				newInfrastructureConfig := infrastructureConfig.DeepCopy()
+				newInfrastructureConfig.Networks.IPFamilies = []apisgcp.IPFamily{apisgcp.IPv4}
+
+				errorList := ValidateInfrastructureConfigUpdate(infrastructureConfig, newInfrastructureConfig, &nodes, &pods, &services)
+
+				Expect(errorList).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("networks.ipFamilies"),
+				}))))
+			})
+
+			It("should forbid reordering existing IP families", func() {
+				infrastructureConfig.Networks.IPFamilies = []apisgcp.IPFamily{apisgcp.IPv4, apisgcp.IPv6}
+				newInfrastructureConfig := infrastructureConfig.DeepCopy()
+				newInfrastructureConfig.Networks.IPFamilies = []apisgcp.IPFamily{apisgcp.IPv6, apisgcp.IPv4}
+
+				errorList := ValidateInfrastructureConfigUpdate(infrastructureConfig, newInfrastructureConfig, &nodes, &pods, &services)
+
+				Expect(errorList).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("networks.ipFamilies"),
+				}))))
+			})
+		})
 	})
 })
\ No newline at end of file
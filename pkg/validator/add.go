@@ -0,0 +1,40 @@
+// Copyright (c) 2018 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package validator
+
+import (
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+)
+
+const (
+	// ValidateShootPath is the path the shoot validation webhook is served on.
+	ValidateShootPath = "/webhooks/validate-shoot"
+	// ValidateSecretBindingPath is the path the secret binding validation webhook is served on.
+	ValidateSecretBindingPath = "/webhooks/validate-secretbinding"
+	// ValidateCloudProfilePath is the path the cloud profile validation webhook is served on.
+	ValidateCloudProfilePath = "/webhooks/validate-cloudprofile"
+)
+
+// AddToManager registers the GCP validating webhook handlers with the manager's webhook server.
+func AddToManager(mgr manager.Manager) error {
+	server := mgr.GetWebhookServer()
+
+	server.Register(ValidateShootPath, &admission.Webhook{Handler: NewShootValidator()})
+	server.Register(ValidateSecretBindingPath, &admission.Webhook{Handler: NewSecretBindingValidator()})
+	server.Register(ValidateCloudProfilePath, &admission.Webhook{Handler: NewCloudProfileValidator()})
+
+	return nil
+}
@@ -0,0 +1,79 @@
+// Copyright (c) 2018 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package validator
+
+import (
+	"context"
+	"net/http"
+
+	gcpvalidation "github.com/gardener/gardener-extension-provider-gcp/pkg/apis/gcp/validation"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+)
+
+// CloudProfileValidator validates CloudProfile resources carrying GCP provider configuration.
+type CloudProfileValidator struct {
+	decoder *admission.Decoder
+}
+
+// NewCloudProfileValidator creates a new validator for CloudProfile resources.
+func NewCloudProfileValidator() *CloudProfileValidator {
+	return &CloudProfileValidator{}
+}
+
+// InjectDecoder injects the decoder into the validator.
+func (v *CloudProfileValidator) InjectDecoder(d *admission.Decoder) error {
+	v.decoder = d
+	return nil
+}
+
+// Handle decodes the incoming CloudProfile and, if it carries GCP provider configuration, runs the GCP-specific
+// validations against it, returning field errors synchronously instead of letting the CloudProfile reach etcd with
+// an invalid provider configuration.
+func (v *CloudProfileValidator) Handle(ctx context.Context, req admission.Request) admission.Response {
+	cloudProfile := &gardencorev1beta1.CloudProfile{}
+	if err := v.decoder.Decode(req, cloudProfile); err != nil {
+		return admission.Errored(http.StatusBadRequest, err)
+	}
+
+	if cloudProfile.Spec.Type != Type {
+		return admission.Allowed("not a GCP cloud profile")
+	}
+
+	allErrs, err := validateCloudProfile(cloudProfile)
+	if err != nil {
+		return admission.Errored(http.StatusInternalServerError, err)
+	}
+	if len(allErrs) > 0 {
+		return admission.Denied(allErrs.ToAggregate().Error())
+	}
+
+	return admission.Allowed("")
+}
+
+func validateCloudProfile(cloudProfile *gardencorev1beta1.CloudProfile) (field.ErrorList, error) {
+	if cloudProfile.Spec.ProviderConfig == nil {
+		return nil, nil
+	}
+
+	profileConfig, err := decodeCloudProfileConfig(cloudProfile.Spec.ProviderConfig)
+	if err != nil {
+		return nil, err
+	}
+
+	return gcpvalidation.ValidateCloudProfileConfig(profileConfig, field.NewPath("spec", "providerConfig")), nil
+}
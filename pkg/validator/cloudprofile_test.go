@@ -0,0 +1,111 @@
+// Copyright (c) 2018 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package validator
+
+import (
+	"context"
+	"encoding/json"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	admissionv1 "k8s.io/api/admission/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+)
+
+var _ = Describe("validateCloudProfile", func() {
+	var cloudProfile *gardencorev1beta1.CloudProfile
+
+	BeforeEach(func() {
+		cloudProfile = &gardencorev1beta1.CloudProfile{
+			Spec: gardencorev1beta1.CloudProfileSpec{
+				Type: Type,
+				ProviderConfig: &runtime.RawExtension{
+					Raw: []byte(`{"machineImages":[{"name":"coreos","versions":[{"version":"1.0.0","image":"coreos-1-0-0"}]}]}`),
+				},
+			},
+		}
+	})
+
+	It("should allow a well-formed CloudProfileConfig", func() {
+		allErrs, err := validateCloudProfile(cloudProfile)
+
+		Expect(err).NotTo(HaveOccurred())
+		Expect(allErrs).To(BeEmpty())
+	})
+
+	It("should reject a machine image without any versions", func() {
+		cloudProfile.Spec.ProviderConfig.Raw = []byte(`{"machineImages":[{"name":"coreos"}]}`)
+
+		allErrs, err := validateCloudProfile(cloudProfile)
+
+		Expect(err).NotTo(HaveOccurred())
+		Expect(allErrs).NotTo(BeEmpty())
+		Expect(allErrs[0].Field).To(Equal("spec.providerConfig.machineImages[0].versions"))
+	})
+
+	It("should allow a CloudProfile without GCP provider configuration", func() {
+		cloudProfile.Spec.ProviderConfig = nil
+
+		allErrs, err := validateCloudProfile(cloudProfile)
+
+		Expect(err).NotTo(HaveOccurred())
+		Expect(allErrs).To(BeEmpty())
+	})
+
+	Describe("CloudProfileValidator.Handle", func() {
+		var handle func(admission.Request) admission.Response
+
+		BeforeEach(func() {
+			scheme := runtime.NewScheme()
+			Expect(gardencorev1beta1.AddToScheme(scheme)).To(Succeed())
+			decoder, err := admission.NewDecoder(scheme)
+			Expect(err).NotTo(HaveOccurred())
+
+			v := NewCloudProfileValidator()
+			Expect(v.InjectDecoder(decoder)).To(Succeed())
+			handle = func(req admission.Request) admission.Response {
+				return v.Handle(context.Background(), req)
+			}
+		})
+
+		It("should deny a CloudProfile admission request with a machine image that has no versions", func() {
+			cloudProfile.Spec.ProviderConfig.Raw = []byte(`{"machineImages":[{"name":"coreos"}]}`)
+			raw, err := json.Marshal(cloudProfile)
+			Expect(err).NotTo(HaveOccurred())
+
+			resp := handle(admission.Request{AdmissionRequest: admissionv1.AdmissionRequest{
+				Operation: admissionv1.Create,
+				Object:    runtime.RawExtension{Raw: raw},
+			}})
+
+			Expect(resp.Allowed).To(BeFalse())
+			Expect(resp.Result.Message).To(ContainSubstring("spec.providerConfig.machineImages[0].versions"))
+		})
+
+		It("should allow a well-formed CloudProfile admission request", func() {
+			raw, err := json.Marshal(cloudProfile)
+			Expect(err).NotTo(HaveOccurred())
+
+			resp := handle(admission.Request{AdmissionRequest: admissionv1.AdmissionRequest{
+				Operation: admissionv1.Create,
+				Object:    runtime.RawExtension{Raw: raw},
+			}})
+
+			Expect(resp.Allowed).To(BeTrue())
+		})
+	})
+})
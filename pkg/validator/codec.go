@@ -0,0 +1,66 @@
+// Copyright (c) 2018 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package validator
+
+import (
+	"encoding/json"
+	"fmt"
+
+	apisgcp "github.com/gardener/gardener-extension-provider-gcp/pkg/apis/gcp"
+
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+// Type is the type of the GCP provider.
+const Type = "gcp"
+
+// decodeInfrastructureConfig decodes the raw `spec.provider.infrastructureConfig` of a Shoot into an
+// `InfrastructureConfig`. A nil or empty raw extension yields a zero-value config so callers can still run the
+// generic network validations.
+func decodeInfrastructureConfig(raw *runtime.RawExtension) (*apisgcp.InfrastructureConfig, error) {
+	infraConfig := &apisgcp.InfrastructureConfig{}
+	if raw == nil || len(raw.Raw) == 0 {
+		return infraConfig, nil
+	}
+	if err := json.Unmarshal(raw.Raw, infraConfig); err != nil {
+		return nil, fmt.Errorf("could not decode infrastructureConfig: %w", err)
+	}
+	return infraConfig, nil
+}
+
+// decodeControlPlaneConfig decodes the raw `spec.provider.controlPlaneConfig` of a Shoot into a
+// `ControlPlaneConfig`.
+func decodeControlPlaneConfig(raw *runtime.RawExtension) (*apisgcp.ControlPlaneConfig, error) {
+	cpConfig := &apisgcp.ControlPlaneConfig{}
+	if raw == nil || len(raw.Raw) == 0 {
+		return cpConfig, nil
+	}
+	if err := json.Unmarshal(raw.Raw, cpConfig); err != nil {
+		return nil, fmt.Errorf("could not decode controlPlaneConfig: %w", err)
+	}
+	return cpConfig, nil
+}
+
+// decodeCloudProfileConfig decodes the raw `spec.providerConfig` of a CloudProfile into a `CloudProfileConfig`.
+func decodeCloudProfileConfig(raw *runtime.RawExtension) (*apisgcp.CloudProfileConfig, error) {
+	profileConfig := &apisgcp.CloudProfileConfig{}
+	if raw == nil || len(raw.Raw) == 0 {
+		return profileConfig, nil
+	}
+	if err := json.Unmarshal(raw.Raw, profileConfig); err != nil {
+		return nil, fmt.Errorf("could not decode providerConfig: %w", err)
+	}
+	return profileConfig, nil
+}
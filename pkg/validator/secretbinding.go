@@ -0,0 +1,55 @@
+// Copyright (c) 2018 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package validator
+
+import (
+	"context"
+	"net/http"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+)
+
+// SecretBindingValidator validates SecretBinding resources carrying GCP provider configuration.
+type SecretBindingValidator struct {
+	decoder *admission.Decoder
+}
+
+// NewSecretBindingValidator creates a new validator for SecretBinding resources.
+func NewSecretBindingValidator() *SecretBindingValidator {
+	return &SecretBindingValidator{}
+}
+
+// InjectDecoder injects the decoder into the validator.
+func (v *SecretBindingValidator) InjectDecoder(d *admission.Decoder) error {
+	v.decoder = d
+	return nil
+}
+
+// Handle decodes the incoming SecretBinding and allows it once confirmed to carry GCP provider configuration. There
+// are currently no GCP-specific validation functions for SecretBinding content, so this only exercises the decode
+// path; the webhook remains registered so future GCP-specific checks have a place to be wired in.
+func (v *SecretBindingValidator) Handle(ctx context.Context, req admission.Request) admission.Response {
+	binding := &gardencorev1beta1.SecretBinding{}
+	if err := v.decoder.Decode(req, binding); err != nil {
+		return admission.Errored(http.StatusBadRequest, err)
+	}
+
+	if binding.Provider == nil || binding.Provider.Type != Type {
+		return admission.Allowed("not a GCP secret binding")
+	}
+
+	return admission.Allowed("")
+}
@@ -0,0 +1,107 @@
+// Copyright (c) 2018 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package validator
+
+import (
+	"context"
+	"net/http"
+
+	gcpvalidation "github.com/gardener/gardener-extension-provider-gcp/pkg/apis/gcp/validation"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	admissionv1 "k8s.io/api/admission/v1"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+)
+
+// ShootValidator validates Shoot resources carrying GCP provider configuration.
+type ShootValidator struct {
+	decoder *admission.Decoder
+}
+
+// NewShootValidator creates a new validator for Shoot resources.
+func NewShootValidator() *ShootValidator {
+	return &ShootValidator{}
+}
+
+// InjectDecoder injects the decoder into the validator.
+func (v *ShootValidator) InjectDecoder(d *admission.Decoder) error {
+	v.decoder = d
+	return nil
+}
+
+// Handle decodes the incoming Shoot and runs the GCP-specific validations against it, returning field errors
+// synchronously instead of letting the Shoot reach etcd with an invalid provider configuration.
+func (v *ShootValidator) Handle(ctx context.Context, req admission.Request) admission.Response {
+	shoot := &gardencorev1beta1.Shoot{}
+	if err := v.decoder.Decode(req, shoot); err != nil {
+		return admission.Errored(http.StatusBadRequest, err)
+	}
+
+	if shoot.Spec.Provider.Type != Type {
+		return admission.Allowed("not a GCP shoot")
+	}
+
+	var oldShoot *gardencorev1beta1.Shoot
+	if req.Operation == admissionv1.Update {
+		oldShoot = &gardencorev1beta1.Shoot{}
+		if err := v.decoder.DecodeRaw(req.OldObject, oldShoot); err != nil {
+			return admission.Errored(http.StatusBadRequest, err)
+		}
+	}
+
+	allErrs, err := validateShoot(shoot, oldShoot)
+	if err != nil {
+		return admission.Errored(http.StatusInternalServerError, err)
+	}
+	if len(allErrs) > 0 {
+		return admission.Denied(allErrs.ToAggregate().Error())
+	}
+
+	return admission.Allowed("")
+}
+
+func validateShoot(shoot, oldShoot *gardencorev1beta1.Shoot) (field.ErrorList, error) {
+	allErrs := field.ErrorList{}
+	providerPath := field.NewPath("spec", "provider")
+
+	infraConfig, err := decodeInfrastructureConfig(shoot.Spec.Provider.InfrastructureConfig)
+	if err != nil {
+		return nil, err
+	}
+	cpConfig, err := decodeControlPlaneConfig(shoot.Spec.Provider.ControlPlaneConfig)
+	if err != nil {
+		return nil, err
+	}
+
+	allErrs = append(allErrs, gcpvalidation.ValidateInfrastructureConfig(infraConfig, shoot.Spec.Networking.Nodes, shoot.Spec.Networking.Pods, shoot.Spec.Networking.Services)...)
+	allErrs = append(allErrs, gcpvalidation.ValidateControlPlaneConfig(cpConfig, providerPath.Child("controlPlaneConfig"))...)
+
+	if oldShoot != nil {
+		oldInfraConfig, err := decodeInfrastructureConfig(oldShoot.Spec.Provider.InfrastructureConfig)
+		if err != nil {
+			return nil, err
+		}
+		oldCPConfig, err := decodeControlPlaneConfig(oldShoot.Spec.Provider.ControlPlaneConfig)
+		if err != nil {
+			return nil, err
+		}
+
+		allErrs = append(allErrs, gcpvalidation.ValidateInfrastructureConfigUpdate(oldInfraConfig, infraConfig, shoot.Spec.Networking.Nodes, shoot.Spec.Networking.Pods, shoot.Spec.Networking.Services)...)
+		allErrs = append(allErrs, gcpvalidation.ValidateControlPlaneConfigUpdate(oldCPConfig, cpConfig, providerPath.Child("controlPlaneConfig"))...)
+	}
+
+	return allErrs, nil
+}
@@ -0,0 +1,160 @@
+// Copyright (c) 2018 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package validator
+
+import (
+	"context"
+	"encoding/json"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	admissionv1 "k8s.io/api/admission/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+)
+
+var _ = Describe("validateShoot", func() {
+	var (
+		nodes, pods, services string
+		shoot                 *gardencorev1beta1.Shoot
+	)
+
+	BeforeEach(func() {
+		nodes = "10.250.0.0/16"
+		pods = "100.96.0.0/11"
+		services = "100.64.0.0/13"
+
+		shoot = &gardencorev1beta1.Shoot{
+			Spec: gardencorev1beta1.ShootSpec{
+				Networking: gardencorev1beta1.Networking{
+					Nodes:    &nodes,
+					Pods:     &pods,
+					Services: &services,
+				},
+				Provider: gardencorev1beta1.Provider{
+					Type: Type,
+					InfrastructureConfig: &runtime.RawExtension{
+						Raw: []byte(`{"networks":{"workers":"10.250.0.0/16"}}`),
+					},
+				},
+			},
+		}
+	})
+
+	It("should reject a malformed worker CIDR", func() {
+		shoot.Spec.Provider.InfrastructureConfig.Raw = []byte(`{"networks":{"workers":"not-a-cidr"}}`)
+
+		allErrs, err := validateShoot(shoot, nil)
+
+		Expect(err).NotTo(HaveOccurred())
+		Expect(allErrs).NotTo(BeEmpty())
+		Expect(allErrs[0].Field).To(Equal("networks.workers"))
+	})
+
+	It("should reject an invalid VPC flow log aggregation interval", func() {
+		shoot.Spec.Provider.InfrastructureConfig.Raw = []byte(`{"networks":{"workers":"10.250.0.0/16","flowLogs":{"aggregationInterval":"bogus"}}}`)
+
+		allErrs, err := validateShoot(shoot, nil)
+
+		Expect(err).NotTo(HaveOccurred())
+		Expect(allErrs).To(ContainElement(WithTransform(func(e interface{ Error() string }) string {
+			return e.Error()
+		}, ContainSubstring("networks.flowLogs.aggregationInterval"))))
+	})
+
+	It("should reject mutating an immutable network section on update", func() {
+		oldShoot := shoot.DeepCopy()
+		shoot.Spec.Provider.InfrastructureConfig.Raw = []byte(`{"networks":{"workers":"10.250.0.0/15"}}`)
+
+		allErrs, err := validateShoot(shoot, oldShoot)
+
+		Expect(err).NotTo(HaveOccurred())
+		Expect(allErrs).To(ContainElement(WithTransform(func(e interface{ Error() string }) string {
+			return e.Error()
+		}, ContainSubstring("networks: Invalid value"))))
+	})
+
+	It("should allow a well-formed, unchanged Shoot", func() {
+		oldShoot := shoot.DeepCopy()
+
+		allErrs, err := validateShoot(shoot, oldShoot)
+
+		Expect(err).NotTo(HaveOccurred())
+		Expect(allErrs).To(BeEmpty())
+	})
+
+	Describe("ShootValidator.Handle", func() {
+		var handle func(admission.Request) admission.Response
+
+		BeforeEach(func() {
+			scheme := runtime.NewScheme()
+			Expect(gardencorev1beta1.AddToScheme(scheme)).To(Succeed())
+			decoder, err := admission.NewDecoder(scheme)
+			Expect(err).NotTo(HaveOccurred())
+
+			v := NewShootValidator()
+			Expect(v.InjectDecoder(decoder)).To(Succeed())
+			handle = func(req admission.Request) admission.Response {
+				return v.Handle(context.Background(), req)
+			}
+		})
+
+		It("should deny a Shoot admission request carrying a malformed worker CIDR", func() {
+			shoot.Spec.Provider.InfrastructureConfig.Raw = []byte(`{"networks":{"workers":"not-a-cidr"}}`)
+			raw, err := json.Marshal(shoot)
+			Expect(err).NotTo(HaveOccurred())
+
+			resp := handle(admission.Request{AdmissionRequest: admissionv1.AdmissionRequest{
+				Operation: admissionv1.Create,
+				Object:    runtime.RawExtension{Raw: raw},
+			}})
+
+			Expect(resp.Allowed).To(BeFalse())
+			Expect(resp.Result.Message).To(ContainSubstring("networks.workers"))
+		})
+
+		It("should deny a Shoot admission request mutating an immutable network section on update", func() {
+			oldShoot := shoot.DeepCopy()
+			oldRaw, err := json.Marshal(oldShoot)
+			Expect(err).NotTo(HaveOccurred())
+
+			shoot.Spec.Provider.InfrastructureConfig.Raw = []byte(`{"networks":{"workers":"10.250.0.0/15"}}`)
+			raw, err := json.Marshal(shoot)
+			Expect(err).NotTo(HaveOccurred())
+
+			resp := handle(admission.Request{AdmissionRequest: admissionv1.AdmissionRequest{
+				Operation: admissionv1.Update,
+				Object:    runtime.RawExtension{Raw: raw},
+				OldObject: runtime.RawExtension{Raw: oldRaw},
+			}})
+
+			Expect(resp.Allowed).To(BeFalse())
+			Expect(resp.Result.Message).To(ContainSubstring("networks: Invalid value"))
+		})
+
+		It("should allow a well-formed Shoot admission request", func() {
+			raw, err := json.Marshal(shoot)
+			Expect(err).NotTo(HaveOccurred())
+
+			resp := handle(admission.Request{AdmissionRequest: admissionv1.AdmissionRequest{
+				Operation: admissionv1.Create,
+				Object:    runtime.RawExtension{Raw: raw},
+			}})
+
+			Expect(resp.Allowed).To(BeTrue())
+		})
+	})
+})